@@ -3,26 +3,46 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 	"slices"
+	"sync/atomic"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 // Constants
 const (
 	PVClaimRefCleanupFinalizer = "liberator.io/pv-claim-ref-cleanup"
+
+	// PVClaimRefManagedAnnotation is stamped by clearClaimRef onto the PV it
+	// clears, recording the UID of the PVC it cleared the claimRef for. It's
+	// the opt-in marker reconcileDanglingClaimRef requires before touching a
+	// PV from the PV-watch side: liberator only ever learns a PVC's PV is
+	// "ours" via the finalizer-driven path, so a PV without this annotation -
+	// or whose annotation doesn't match the claimRef it currently carries -
+	// was never under liberator's management and must be left alone.
+	PVClaimRefManagedAnnotation = "liberator.io/managed-claimref-uid"
 )
 
 var (
@@ -31,6 +51,12 @@ var (
 	enableLeaderElection    bool
 	probeAddr               string
 	maxConcurrentReconciles int
+	addFinalizer            bool
+	finalizerSelectorRaw    string
+	uninstallProtection     bool
+	metricsAddr             string
+	stuckDeletingThreshold  time.Duration
+	stuckDeletingInterval   time.Duration
 )
 
 func init() {
@@ -43,6 +69,23 @@ func main() {
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 3, "The maximum number of concurrent reconciles for the controller.")
+	flag.BoolVar(&addFinalizer, "add-finalizer", false,
+		"Enable auto-finalizer mode: watch matching PVCs and patch the "+PVClaimRefCleanupFinalizer+" finalizer onto "+
+			"them instead of requiring it to be pre-applied. Disabled by default; PVCs must opt in explicitly.")
+	flag.StringVar(&finalizerSelectorRaw, "finalizer-selector", "",
+		"Label selector (e.g. \"liberator.io/manage=true\") used to pick which PVCs get the cleanup finalizer "+
+			"auto-applied. Matched against both labels and annotations. Only consulted when --add-finalizer is set; "+
+			"an empty selector matches every PVC.")
+	flag.BoolVar(&uninstallProtection, "uninstall-protection", true,
+		"Protect liberator's own Deployment from deletion while it's still managing PVCs, draining them on "+
+			"uninstall instead of leaving them stuck with a finalizer and no controller left to remove it. "+
+			"Requires POD_NAME/POD_NAMESPACE to be set via the downward API.")
+	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metrics endpoint binds to.")
+	flag.DurationVar(&stuckDeletingThreshold, "stuck-deleting-threshold", 5*time.Minute,
+		"How long a PVC can sit with liberator's finalizer and a DeletionTimestamp before "+
+			"liberator_pvc_stuck_deleting counts it as stuck.")
+	flag.DurationVar(&stuckDeletingInterval, "stuck-deleting-check-interval", 30*time.Second,
+		"How often to recompute liberator_pvc_stuck_deleting.")
 
 	opts := zap.Options{
 		Development: true,
@@ -52,8 +95,15 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	finalizerSelector, err := newFinalizerSelector(finalizerSelectorRaw)
+	if err != nil {
+		setupLog.Error(err, "invalid --finalizer-selector")
+		os.Exit(1)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
+		Metrics:                metricsserver.Options{BindAddress: metricsAddr},
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "liberator-leader-election",
@@ -63,15 +113,45 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = (&PVCReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-		Log:    ctrl.Log.WithName("controllers").WithName("PVC"),
-	}).SetupWithManager(mgr); err != nil {
+	pvcReconciler := &PVCReconciler{
+		Client:            mgr.GetClient(),
+		Scheme:            mgr.GetScheme(),
+		Log:               ctrl.Log.WithName("controllers").WithName("PVC"),
+		Recorder:          mgr.GetEventRecorderFor("liberator-pvc-controller"),
+		AddFinalizer:      addFinalizer,
+		FinalizerSelector: finalizerSelector,
+	}
+	if err = pvcReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "PVC")
 		os.Exit(1)
 	}
 
+	if uninstallProtection {
+		ownDeployment, err := discoverOwnDeployment(context.Background(), mgr.GetAPIReader())
+		if err != nil {
+			setupLog.Error(err, "unable to discover own Deployment, disabling uninstall protection")
+		} else if err := (&DeploymentReconciler{
+			Client:        mgr.GetClient(),
+			Scheme:        mgr.GetScheme(),
+			Log:           ctrl.Log.WithName("controllers").WithName("Deployment"),
+			Name:          ownDeployment,
+			PVCReconciler: pvcReconciler,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Deployment")
+			os.Exit(1)
+		}
+	}
+
+	if err := mgr.Add(&stuckDeletingMonitor{
+		Client:    mgr.GetClient(),
+		Log:       ctrl.Log.WithName("controllers").WithName("stuck-deleting-monitor"),
+		Threshold: stuckDeletingThreshold,
+		Interval:  stuckDeletingInterval,
+	}); err != nil {
+		setupLog.Error(err, "unable to add stuck-deleting monitor")
+		os.Exit(1)
+	}
+
 	// Add health check endpoints
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
@@ -90,32 +170,100 @@ func main() {
 }
 
 // PVCReconciler reconciles a PVC object
+//
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 type PVCReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
-	Log    logr.Logger
+	Scheme   *runtime.Scheme
+	Log      logr.Logger
+	Recorder record.EventRecorder
+
+	// AddFinalizer enables auto-finalizer mode: PVCs matching FinalizerSelector
+	// have PVClaimRefCleanupFinalizer patched onto them instead of requiring it
+	// to be pre-applied by the user.
+	AddFinalizer bool
+	// FinalizerSelector restricts auto-finalizer mode to matching PVCs. Ignored
+	// when AddFinalizer is false.
+	FinalizerSelector *finalizerSelector
+
+	// DrainMode is flipped on by the uninstall-protection controller once
+	// liberator's own Deployment starts deleting. While set, Reconcile treats
+	// every PVC still carrying our finalizer as deletable regardless of its
+	// own DeletionTimestamp, so the finalizer doesn't outlive the controller.
+	DrainMode atomic.Bool
 }
 
 // Reconcile processes PVC events
-func (r *PVCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *PVCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	start := time.Now()
+	defer func() {
+		reconcileDurationSeconds.Observe(time.Since(start).Seconds())
+		pvcReconcilesTotal.WithLabelValues(reconcileResultLabel(err)).Inc()
+	}()
+
 	logger := r.Log.WithValues("pvc", req.NamespacedName)
 	logger.Info("Received Event for PVC")
 
 	// Get the PVC
 	var pvc corev1.PersistentVolumeClaim
 	if err := r.Get(ctx, req.NamespacedName, &pvc); err != nil {
-		// The PVC no longer exists, which means it's been deleted
-		// No need to requeue
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+		if apierrors.IsNotFound(err) {
+			// The PVC is gone. Normally our own finalizer handling already
+			// cleared its PV's claimRef before that happened - but the PV
+			// watch can also land us here for a PV whose claimRef points at
+			// a PVC that's already been deleted (e.g. a stale claimRef on a
+			// recreated PV, or a race with delayed PV status writes). Clear
+			// any such dangling claimRef directly.
+			return r.reconcileDanglingClaimRef(ctx, req.NamespacedName, logger)
+		}
+		return ctrl.Result{}, err
 	}
 
-	// If PVC is being deleted and has our finalizer, handle the cleanup
+	// If PVC is being deleted and has our finalizer, handle the cleanup.
 	if !pvc.DeletionTimestamp.IsZero() && hasFinalizer(pvc.Finalizers) {
 		return r.handlePVCDeletion(ctx, &pvc, logger)
 	}
 
-	// If it's not being deleted or doesn't have our finalizer, nothing to do
-	// We don't add finalizers as per requirements - users will add them
+	// In drain mode (our own Deployment is being uninstalled) we force-remove
+	// the finalizer from every matching PVC that ISN'T actually being deleted,
+	// since no reconciler will be left to do it once we're gone. We only
+	// remove the finalizer here, not the claimRef - the PVC is still in use,
+	// and nil-ing a live PV's claimRef risks it rebinding to something else
+	// while still mounted.
+	if r.DrainMode.Load() && pvc.DeletionTimestamp.IsZero() && hasFinalizer(pvc.Finalizers) {
+		return r.removeFinalizer(ctx, &pvc, logger)
+	}
+
+	// In auto-finalizer mode, apply the finalizer to matching PVCs that don't
+	// have it yet so liberator can manage them without a pre-applied opt-in.
+	if pvc.DeletionTimestamp.IsZero() && r.AddFinalizer && !hasFinalizer(pvc.Finalizers) && r.FinalizerSelector.Matches(&pvc) {
+		return r.applyFinalizer(ctx, &pvc, logger)
+	}
+
+	// Otherwise there's nothing to do - by default we don't add finalizers,
+	// users (or auto-finalizer mode above) are responsible for that.
+	return ctrl.Result{}, nil
+}
+
+// applyFinalizer idempotently patches PVClaimRefCleanupFinalizer onto a PVC
+// selected by auto-finalizer mode.
+func (r *PVCReconciler) applyFinalizer(ctx context.Context, pvc *corev1.PersistentVolumeClaim, logger logr.Logger) (ctrl.Result, error) {
+	if hasFinalizer(pvc.Finalizers) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Applying finalizer to PVC", "finalizer", PVClaimRefCleanupFinalizer)
+
+	patch := client.MergeFrom(pvc.DeepCopy())
+	pvc.Finalizers = append(pvc.Finalizers, PVClaimRefCleanupFinalizer)
+	if err := r.Patch(ctx, pvc, patch); err != nil {
+		logger.Error(err, "Failed to apply finalizer to PVC")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Successfully applied finalizer to PVC")
 	return ctrl.Result{}, nil
 }
 
@@ -125,57 +273,200 @@ func (r *PVCReconciler) handlePVCDeletion(ctx context.Context, pvc *corev1.Persi
 
 	// If this PVC has a PV bound to it, clean the claimRef
 	if pvc.Spec.VolumeName != "" {
-		// Get the PV
+		if err := r.clearClaimRef(ctx, pvc, logger); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Remove our finalizer to allow the PVC to be deleted
+	return r.removeFinalizer(ctx, pvc, logger)
+}
+
+// clearClaimRef clears ClaimRef on the PV bound to pvc, if it still points
+// back at pvc. It re-fetches the PV and retries on update conflicts, since
+// the PV can be concurrently mutated (e.g. by a CSI provisioner) while
+// liberator is draining it, and patches rather than does a full update so we
+// don't stomp on unrelated spec fields another controller owns.
+func (r *PVCReconciler) clearClaimRef(ctx context.Context, pvc *corev1.PersistentVolumeClaim, logger logr.Logger) error {
+	pvName := pvc.Spec.VolumeName
+
+	actions, parseErr := parseReleaseActions(pvc.Annotations)
+	if parseErr != nil {
+		logger.Error(parseErr, "Ignoring invalid on-release annotations")
+		if r.Recorder != nil {
+			r.Recorder.Event(pvc, corev1.EventTypeWarning, "InvalidReleaseAnnotations", parseErr.Error())
+		}
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		var pv corev1.PersistentVolume
-		if err := r.Get(ctx, client.ObjectKey{Name: pvc.Spec.VolumeName}, &pv); err != nil {
-			logger.Error(err, "Failed to get PV", "pvName", pvc.Spec.VolumeName)
-			// If the PV doesn't exist or there was another error getting it,
-			// we can still proceed with finalizer removal
-			if client.IgnoreNotFound(err) != nil {
-				return ctrl.Result{}, err
-			}
-		} else {
-			// If PV exists and has a claimRef to our PVC
-			if pv.Spec.ClaimRef != nil &&
-				pv.Spec.ClaimRef.Name == pvc.Name &&
-				pv.Spec.ClaimRef.Namespace == pvc.Namespace {
+		if err := r.Get(ctx, client.ObjectKey{Name: pvName}, &pv); err != nil {
+			// If the PV doesn't exist there's nothing left to clean up.
+			return client.IgnoreNotFound(err)
+		}
 
-				logger.Info("Clearing claimRef from PV", "pvName", pv.Name)
+		if pv.Spec.ClaimRef == nil ||
+			pv.Spec.ClaimRef.Name != pvc.Name ||
+			pv.Spec.ClaimRef.Namespace != pvc.Namespace {
+			return nil
+		}
 
-				// Create a copy of the PV and clear the claimRef
-				pvCopy := pv.DeepCopy()
-				pvCopy.Spec.ClaimRef = nil
+		logger.Info("Clearing claimRef from PV", "pvName", pv.Name)
 
-				// Update the PV
-				if err := r.Update(ctx, pvCopy); err != nil {
-					logger.Error(err, "Failed to update PV", "pvName", pv.Name)
-					return ctrl.Result{}, err
-				}
+		patch := client.MergeFromWithOptions(pv.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		pv.Spec.ClaimRef = nil
+		if pv.Annotations == nil {
+			pv.Annotations = map[string]string{}
+		}
+		pv.Annotations[PVClaimRefManagedAnnotation] = string(pvc.UID)
+
+		var applied []string
+		if parseErr == nil && !actions.IsEmpty() {
+			applied = actions.Apply(&pv)
+		}
 
-				logger.Info("Successfully cleared claimRef from PV", "pvName", pv.Name)
+		if err := r.Patch(ctx, &pv, patch); err != nil {
+			return err
+		}
+
+		logger.Info("Successfully cleared claimRef from PV", "pvName", pv.Name)
+		pvClaimRefClearedTotal.Inc()
+		for _, action := range applied {
+			logger.Info("Applied on-release action to PV", "pvName", pv.Name, "action", action)
+			if r.Recorder != nil {
+				r.Recorder.Eventf(pvc, corev1.EventTypeNormal, "ReleaseActionApplied", "%s on PV %s", action, pv.Name)
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "Failed to clear claimRef from PV", "pvName", pvName)
 	}
+	return err
+}
 
-	// Remove our finalizer to allow the PVC to be deleted
-	return r.removeFinalizer(ctx, pvc, logger)
+// pvClaimRefIndexKey is the field index used to look up PVs by the
+// namespaced name of the PVC in their ClaimRef, so reconcileDanglingClaimRef
+// doesn't need to list and scan every PV.
+const pvClaimRefIndexKey = ".spec.claimRef.namespacedName"
+
+// pvClaimRefIndexer indexes a PV by "namespace/name" of its ClaimRef.
+func pvClaimRefIndexer(obj client.Object) []string {
+	pv, ok := obj.(*corev1.PersistentVolume)
+	if !ok || pv.Spec.ClaimRef == nil {
+		return nil
+	}
+	return []string{pv.Spec.ClaimRef.Namespace + "/" + pv.Spec.ClaimRef.Name}
 }
 
-// removeFinalizer removes our finalizer from the PVC
+// pvToPVC maps a PV event to a reconcile request for the PVC in its
+// ClaimRef, so editing a PV (or its claimRef being re-added by another
+// actor) is noticed even though PVCReconciler otherwise only watches PVCs.
+func pvToPVC(ctx context.Context, obj client.Object) []reconcile.Request {
+	pv, ok := obj.(*corev1.PersistentVolume)
+	if !ok || pv.Spec.ClaimRef == nil {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: pv.Spec.ClaimRef.Namespace,
+		Name:      pv.Spec.ClaimRef.Name,
+	}}}
+}
+
+// reconcileDanglingClaimRef clears ClaimRef on every PV that still points at
+// pvcKey even though that PVC no longer exists - closing the race between
+// PVC deletion completing and delayed PV status writes seen in some CSI
+// provisioners. It only ever touches PVs liberator itself previously cleared
+// (see clearDanglingClaimRef) - a stale claimRef on a PV liberator was never
+// managing is left alone.
+func (r *PVCReconciler) reconcileDanglingClaimRef(ctx context.Context, pvcKey types.NamespacedName, logger logr.Logger) (ctrl.Result, error) {
+	var pvs corev1.PersistentVolumeList
+	if err := r.List(ctx, &pvs, client.MatchingFields{pvClaimRefIndexKey: pvcKey.Namespace + "/" + pvcKey.Name}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing PVs for dangling claimRef %s: %w", pvcKey, err)
+	}
+
+	for i := range pvs.Items {
+		pv := &pvs.Items[i]
+		if err := r.clearDanglingClaimRef(ctx, pv, logger); err != nil {
+			logger.Error(err, "Failed to clear dangling claimRef from PV", "pvName", pv.Name)
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// clearDanglingClaimRef re-fetches pv and clears its ClaimRef, retrying on
+// update conflicts like clearClaimRef does. It only clears the claimRef if
+// PVClaimRefManagedAnnotation on the PV matches the UID of the claimRef it
+// currently carries - i.e. liberator itself cleared this exact PVC's
+// claimRef before and something wrote it back - and the PV isn't Retain,
+// since Retain intentionally keeps a released PV's claimRef so it stays
+// Released instead of auto-rebinding. A PV liberator was never opted into
+// managing, or one that's since been rebound to a different PVC, is left
+// untouched.
+func (r *PVCReconciler) clearDanglingClaimRef(ctx context.Context, pv *corev1.PersistentVolume, logger logr.Logger) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var latest corev1.PersistentVolume
+		if err := r.Get(ctx, client.ObjectKeyFromObject(pv), &latest); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		if latest.Spec.ClaimRef == nil {
+			return nil
+		}
+
+		if latest.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimRetain {
+			logger.Info("Not clearing dangling claimRef: PV is Retain", "pvName", latest.Name)
+			return nil
+		}
+
+		managedUID, ok := latest.Annotations[PVClaimRefManagedAnnotation]
+		if !ok || managedUID == "" || string(latest.Spec.ClaimRef.UID) != managedUID {
+			logger.Info("Not clearing dangling claimRef: PV was never opted into liberator management for this claim", "pvName", latest.Name)
+			return nil
+		}
+
+		logger.Info("Clearing dangling claimRef from PV for deleted PVC", "pvName", latest.Name)
+
+		patch := client.MergeFromWithOptions(latest.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		latest.Spec.ClaimRef = nil
+		if err := r.Patch(ctx, &latest, patch); err != nil {
+			return err
+		}
+
+		logger.Info("Successfully cleared dangling claimRef from PV", "pvName", latest.Name)
+		pvClaimRefClearedTotal.Inc()
+		return nil
+	})
+}
+
+// removeFinalizer removes our finalizer from the PVC. It re-fetches the PVC
+// and retries on update conflicts for the same reason clearClaimRef does.
 func (r *PVCReconciler) removeFinalizer(ctx context.Context, pvc *corev1.PersistentVolumeClaim, logger logr.Logger) (ctrl.Result, error) {
 	logger.Info("Removing finalizer from PVC")
 
-	// Create a copy and remove the finalizer
-	pvcCopy := pvc.DeepCopy()
-	pvcCopy.Finalizers = removeFinalizer(pvcCopy.Finalizers)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var latest corev1.PersistentVolumeClaim
+		if err := r.Get(ctx, client.ObjectKeyFromObject(pvc), &latest); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		if !hasFinalizer(latest.Finalizers) {
+			return nil
+		}
 
-	// Update the PVC
-	if err := r.Update(ctx, pvcCopy); err != nil {
+		patch := client.MergeFromWithOptions(latest.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		latest.Finalizers = removeFinalizer(latest.Finalizers)
+		return r.Patch(ctx, &latest, patch)
+	})
+	if err != nil {
 		logger.Error(err, "Failed to remove finalizer from PVC")
 		return ctrl.Result{}, err
 	}
 
 	logger.Info("Successfully removed finalizer from PVC")
+	finalizerRemovedTotal.Inc()
 	return ctrl.Result{}, nil
 }
 
@@ -195,6 +486,38 @@ func removeFinalizer(finalizers []string) []string {
 	return result
 }
 
+// finalizerSelector decides which PVCs auto-finalizer mode applies to. It
+// parses the --finalizer-selector flag as a standard label selector and
+// matches it against both a PVC's labels and its annotations, so the same
+// "key=value" syntax (e.g. "liberator.io/manage=true") works for either.
+type finalizerSelector struct {
+	selector labels.Selector
+}
+
+// newFinalizerSelector parses raw into a finalizerSelector. An empty raw
+// selector matches every PVC.
+func newFinalizerSelector(raw string) (*finalizerSelector, error) {
+	if raw == "" {
+		return &finalizerSelector{selector: labels.Everything()}, nil
+	}
+	sel, err := labels.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parsing finalizer selector %q: %w", raw, err)
+	}
+	return &finalizerSelector{selector: sel}, nil
+}
+
+// Matches reports whether pvc's labels or annotations satisfy the selector.
+func (s *finalizerSelector) Matches(pvc *corev1.PersistentVolumeClaim) bool {
+	if s == nil || s.selector == nil {
+		return false
+	}
+	if s.selector.Matches(labels.Set(pvc.Labels)) {
+		return true
+	}
+	return s.selector.Matches(labels.Set(pvc.Annotations))
+}
+
 func (r *PVCReconciler) SetupWithManager(mgr manager.Manager) error {
 	hasBoundPVPredicate := predicate.NewPredicateFuncs(func(obj client.Object) bool {
 		// Skip PVCs without a bound PV
@@ -229,9 +552,42 @@ func (r *PVCReconciler) SetupWithManager(mgr manager.Manager) error {
 		predicates,
 	)
 
+	// In auto-finalizer mode we also need Create/Update/Generic events for
+	// PVCs that don't have the finalizer yet but match FinalizerSelector, so
+	// Reconcile gets a chance to apply it - regardless of bind state, since
+	// the finalizer should land before a PVC is bound.
+	autoFinalizerPredicate := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		if !r.AddFinalizer {
+			return false
+		}
+		pvc, ok := obj.(*corev1.PersistentVolumeClaim)
+		if !ok {
+			return false
+		}
+		return !hasFinalizer(pvc.Finalizers) && r.FinalizerSelector.Matches(pvc)
+	})
+
+	isPVC := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := obj.(*corev1.PersistentVolumeClaim)
+		return ok
+	})
+
+	// WithEventFilter applies to every watch below, including the PV one, so
+	// gate the PVC-shaped predicates above behind isPVC and let PV events
+	// through their own predicate instead.
+	pvPredicate := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		pv, ok := obj.(*corev1.PersistentVolume)
+		return ok && pv.Spec.ClaimRef != nil
+	})
+
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &corev1.PersistentVolume{}, pvClaimRefIndexKey, pvClaimRefIndexer); err != nil {
+		return fmt.Errorf("indexing PV claimRef: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&corev1.PersistentVolumeClaim{}).
-		WithEventFilter(combined).
+		Watches(&corev1.PersistentVolume{}, handler.EnqueueRequestsFromMapFunc(pvToPVC)).
+		WithEventFilter(predicate.Or(predicate.And(isPVC, predicate.Or(combined, autoFinalizerPredicate)), pvPredicate)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: maxConcurrentReconciles,
 		}).
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Prometheus metrics registered with controller-runtime's metrics.Registry
+// and served on --metrics-bind-address.
+var (
+	pvcReconcilesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "liberator_pvc_reconciles_total",
+		Help: "Total number of PVC reconciles, by result.",
+	}, []string{"result"})
+
+	pvClaimRefClearedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "liberator_pv_claimref_cleared_total",
+		Help: "Total number of PV claimRefs cleared by liberator.",
+	})
+
+	finalizerRemovedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "liberator_finalizer_removed_total",
+		Help: "Total number of times liberator removed its finalizer from a PVC.",
+	})
+
+	reconcileDurationSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "liberator_reconcile_duration_seconds",
+		Help:    "Time taken by each PVC reconcile.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	// pvcStuckDeleting is kept up to date by stuckDeletingMonitor rather than
+	// from inside Reconcile, since it reflects PVCs that AREN'T being
+	// reconciled (that's the whole problem it surfaces).
+	pvcStuckDeleting = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "liberator_pvc_stuck_deleting",
+		Help: "Number of PVCs carrying liberator's finalizer whose DeletionTimestamp is older than --stuck-deleting-threshold.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		pvcReconcilesTotal,
+		pvClaimRefClearedTotal,
+		finalizerRemovedTotal,
+		reconcileDurationSeconds,
+		pvcStuckDeleting,
+	)
+}
+
+// reconcileResultLabel turns a Reconcile error into the "result" label value
+// for liberator_pvc_reconciles_total.
+func reconcileResultLabel(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
+
+// stuckDeletingMonitor periodically recomputes liberator_pvc_stuck_deleting,
+// the SLI for finalizer-leak scenarios (e.g. a PVC whose PV cleanup keeps
+// conflicting, or one missed by every watch). It's registered with the
+// manager as a Runnable rather than driven from Reconcile so it still
+// reports on PVCs that no reconcile is currently touching.
+type stuckDeletingMonitor struct {
+	client.Client
+	Log       logr.Logger
+	Threshold time.Duration
+	Interval  time.Duration
+}
+
+// Start implements manager.Runnable.
+func (m *stuckDeletingMonitor) Start(ctx context.Context) error {
+	ticker := time.NewTicker(m.Interval)
+	defer ticker.Stop()
+
+	m.refresh(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			m.refresh(ctx)
+		}
+	}
+}
+
+func (m *stuckDeletingMonitor) refresh(ctx context.Context) {
+	var pvcs corev1.PersistentVolumeClaimList
+	if err := m.List(ctx, &pvcs); err != nil {
+		m.Log.Error(err, "Failed to list PVCs for liberator_pvc_stuck_deleting")
+		return
+	}
+
+	now := time.Now()
+	stuck := 0
+	for _, pvc := range pvcs.Items {
+		if pvc.DeletionTimestamp.IsZero() || !hasFinalizer(pvc.Finalizers) {
+			continue
+		}
+		if now.Sub(pvc.DeletionTimestamp.Time) > m.Threshold {
+			stuck++
+		}
+	}
+
+	pvcStuckDeleting.Set(float64(stuck))
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// conflictInjectingClient wraps a client.Client and returns a Conflict error
+// from its first `remaining` Patch calls before delegating normally, so
+// tests can exercise the retry.RetryOnConflict loops in clearClaimRef and
+// removeFinalizer.
+type conflictInjectingClient struct {
+	client.Client
+	remaining int
+}
+
+func (c *conflictInjectingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if c.remaining > 0 {
+		c.remaining--
+		return apierrors.NewConflict(schema.GroupResource{Resource: "test"}, obj.GetName(), fmt.Errorf("injected conflict"))
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func TestClearClaimRef_RetriesOnConflict(t *testing.T) {
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+		Spec: corev1.PersistentVolumeSpec{
+			ClaimRef: &corev1.ObjectReference{Name: "pvc-1", Namespace: "default"},
+		},
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc-1", Namespace: "default"},
+		Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-1"},
+	}
+
+	base := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pv).Build()
+	r := &PVCReconciler{Client: &conflictInjectingClient{Client: base, remaining: 2}}
+
+	if err := r.clearClaimRef(context.Background(), pvc, logr.Discard()); err != nil {
+		t.Fatalf("clearClaimRef returned error: %v", err)
+	}
+
+	var got corev1.PersistentVolume
+	if err := base.Get(context.Background(), client.ObjectKeyFromObject(pv), &got); err != nil {
+		t.Fatalf("getting pv: %v", err)
+	}
+	if got.Spec.ClaimRef != nil {
+		t.Fatalf("expected claimRef to be cleared, got %+v", got.Spec.ClaimRef)
+	}
+}
+
+func TestClearClaimRef_GivesUpAfterTooManyConflicts(t *testing.T) {
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+		Spec: corev1.PersistentVolumeSpec{
+			ClaimRef: &corev1.ObjectReference{Name: "pvc-1", Namespace: "default"},
+		},
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc-1", Namespace: "default"},
+		Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-1"},
+	}
+
+	base := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pv).Build()
+	// retry.DefaultRetry allows 5 steps; exceed it so the retry loop surfaces the conflict.
+	r := &PVCReconciler{Client: &conflictInjectingClient{Client: base, remaining: 10}}
+
+	if err := r.clearClaimRef(context.Background(), pvc, logr.Discard()); err == nil {
+		t.Fatal("expected clearClaimRef to return an error after exhausting retries")
+	}
+}
+
+func TestRemoveFinalizer_RetriesOnConflict(t *testing.T) {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "pvc-1",
+			Namespace:  "default",
+			Finalizers: []string{PVClaimRefCleanupFinalizer},
+		},
+	}
+
+	base := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pvc).Build()
+	r := &PVCReconciler{Client: &conflictInjectingClient{Client: base, remaining: 2}}
+
+	if _, err := r.removeFinalizer(context.Background(), pvc, logr.Discard()); err != nil {
+		t.Fatalf("removeFinalizer returned error: %v", err)
+	}
+
+	var got corev1.PersistentVolumeClaim
+	if err := base.Get(context.Background(), client.ObjectKeyFromObject(pvc), &got); err != nil {
+		t.Fatalf("getting pvc: %v", err)
+	}
+	if hasFinalizer(got.Finalizers) {
+		t.Fatalf("expected finalizer to be removed, got %+v", got.Finalizers)
+	}
+}
+
+func TestClearDanglingClaimRef_SkipsPVNeverManagedByLiberator(t *testing.T) {
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+		Spec: corev1.PersistentVolumeSpec{
+			ClaimRef:                      &corev1.ObjectReference{Name: "pvc-1", Namespace: "default", UID: "pvc-uid-1"},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+		},
+	}
+
+	base := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pv).Build()
+	r := &PVCReconciler{Client: base}
+
+	if err := r.clearDanglingClaimRef(context.Background(), pv, logr.Discard()); err != nil {
+		t.Fatalf("clearDanglingClaimRef returned error: %v", err)
+	}
+
+	var got corev1.PersistentVolume
+	if err := base.Get(context.Background(), client.ObjectKeyFromObject(pv), &got); err != nil {
+		t.Fatalf("getting pv: %v", err)
+	}
+	if got.Spec.ClaimRef == nil {
+		t.Fatal("expected claimRef to be left alone on a PV liberator was never opted into managing")
+	}
+}
+
+func TestClearDanglingClaimRef_SkipsRetainPolicy(t *testing.T) {
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pv-1",
+			Annotations: map[string]string{PVClaimRefManagedAnnotation: "pvc-uid-1"},
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			ClaimRef:                      &corev1.ObjectReference{Name: "pvc-1", Namespace: "default", UID: "pvc-uid-1"},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+		},
+	}
+
+	base := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pv).Build()
+	r := &PVCReconciler{Client: base}
+
+	if err := r.clearDanglingClaimRef(context.Background(), pv, logr.Discard()); err != nil {
+		t.Fatalf("clearDanglingClaimRef returned error: %v", err)
+	}
+
+	var got corev1.PersistentVolume
+	if err := base.Get(context.Background(), client.ObjectKeyFromObject(pv), &got); err != nil {
+		t.Fatalf("getting pv: %v", err)
+	}
+	if got.Spec.ClaimRef == nil {
+		t.Fatal("expected claimRef to be left alone on a Retain PV")
+	}
+}
+
+func TestClearDanglingClaimRef_ClearsOptedInPV(t *testing.T) {
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pv-1",
+			Annotations: map[string]string{PVClaimRefManagedAnnotation: "pvc-uid-1"},
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			ClaimRef:                      &corev1.ObjectReference{Name: "pvc-1", Namespace: "default", UID: "pvc-uid-1"},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+		},
+	}
+
+	base := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pv).Build()
+	r := &PVCReconciler{Client: base}
+
+	if err := r.clearDanglingClaimRef(context.Background(), pv, logr.Discard()); err != nil {
+		t.Fatalf("clearDanglingClaimRef returned error: %v", err)
+	}
+
+	var got corev1.PersistentVolume
+	if err := base.Get(context.Background(), client.ObjectKeyFromObject(pv), &got); err != nil {
+		t.Fatalf("getting pv: %v", err)
+	}
+	if got.Spec.ClaimRef != nil {
+		t.Fatalf("expected claimRef to be cleared, got %+v", got.Spec.ClaimRef)
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Annotations read off a PVC to drive post-release mutations on its bound PV
+// when liberator clears the claimRef, e.g. to flip Retain->Delete after
+// handoff or relabel the PV so a selector on a new PVC picks it up.
+const (
+	OnReleaseReclaimPolicyAnnotation = "liberator.io/on-release-reclaim-policy"
+	OnReleaseLabelsAnnotation        = "liberator.io/on-release-labels"
+	OnReleaseAnnotationsAnnotation   = "liberator.io/on-release-annotations"
+)
+
+// releaseActions holds the post-release mutations requested via a PVC's
+// liberator.io/on-release-* annotations, parsed and validated once so
+// clearClaimRef can apply them alongside clearing the PV's ClaimRef.
+type releaseActions struct {
+	reclaimPolicy *corev1.PersistentVolumeReclaimPolicy
+	labels        map[string]string
+	annotations   map[string]string
+}
+
+// parseReleaseActions reads the liberator.io/on-release-* annotations off a
+// PVC and validates them. A PVC with none of these annotations set yields a
+// zero-value releaseActions whose Apply is a no-op.
+func parseReleaseActions(pvcAnnotations map[string]string) (releaseActions, error) {
+	var actions releaseActions
+
+	if raw, ok := pvcAnnotations[OnReleaseReclaimPolicyAnnotation]; ok {
+		policy := corev1.PersistentVolumeReclaimPolicy(raw)
+		switch policy {
+		case corev1.PersistentVolumeReclaimRetain, corev1.PersistentVolumeReclaimDelete, corev1.PersistentVolumeReclaimRecycle:
+			actions.reclaimPolicy = &policy
+		default:
+			return releaseActions{}, fmt.Errorf("%s: invalid reclaim policy %q", OnReleaseReclaimPolicyAnnotation, raw)
+		}
+	}
+
+	if raw, ok := pvcAnnotations[OnReleaseLabelsAnnotation]; ok {
+		parsed, err := parseKeyValueList(raw)
+		if err != nil {
+			return releaseActions{}, fmt.Errorf("%s: %w", OnReleaseLabelsAnnotation, err)
+		}
+		actions.labels = parsed
+	}
+
+	if raw, ok := pvcAnnotations[OnReleaseAnnotationsAnnotation]; ok {
+		parsed, err := parseKeyValueList(raw)
+		if err != nil {
+			return releaseActions{}, fmt.Errorf("%s: %w", OnReleaseAnnotationsAnnotation, err)
+		}
+		actions.annotations = parsed
+	}
+
+	return actions, nil
+}
+
+// parseKeyValueList parses a comma-separated "key=value,key2=value2" list.
+func parseKeyValueList(raw string) (map[string]string, error) {
+	result := map[string]string{}
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid key=value pair %q", pair)
+		}
+		result[k] = v
+	}
+	return result, nil
+}
+
+// IsEmpty reports whether no post-release actions were requested.
+func (a releaseActions) IsEmpty() bool {
+	return a.reclaimPolicy == nil && len(a.labels) == 0 && len(a.annotations) == 0
+}
+
+// Apply mutates pv in place per the requested actions and returns a
+// human-readable description of each change made, for event recording.
+func (a releaseActions) Apply(pv *corev1.PersistentVolume) []string {
+	var applied []string
+
+	if a.reclaimPolicy != nil && pv.Spec.PersistentVolumeReclaimPolicy != *a.reclaimPolicy {
+		applied = append(applied, fmt.Sprintf("set reclaim policy to %s", *a.reclaimPolicy))
+		pv.Spec.PersistentVolumeReclaimPolicy = *a.reclaimPolicy
+	}
+
+	if len(a.labels) > 0 {
+		if pv.Labels == nil {
+			pv.Labels = map[string]string{}
+		}
+		for k, v := range a.labels {
+			pv.Labels[k] = v
+		}
+		applied = append(applied, fmt.Sprintf("merged %d label(s)", len(a.labels)))
+	}
+
+	if len(a.annotations) > 0 {
+		if pv.Annotations == nil {
+			pv.Annotations = map[string]string{}
+		}
+		for k, v := range a.annotations {
+			pv.Annotations[k] = v
+		}
+		applied = append(applied, fmt.Sprintf("merged %d annotation(s)", len(a.annotations)))
+	}
+
+	return applied
+}
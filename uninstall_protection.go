@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// UninstallProtectionFinalizer guards liberator's own Deployment against
+// deletion while it's still managing PVCs, so `helm uninstall` (or any other
+// deletion of the Deployment) can't strand them with a finalizer and no
+// controller left to drain it.
+const UninstallProtectionFinalizer = "liberator.io/uninstall-protection"
+
+// DeploymentReconciler watches liberator's own Deployment, discovered at
+// startup via the downward API, and keeps it from disappearing while it's
+// still managing PVCs. Once the Deployment starts deleting, it switches
+// PVCReconciler into drain mode and force-removes the cleanup finalizer from
+// every managed PVC before releasing its own finalizer.
+//
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=list;watch
+type DeploymentReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+
+	// Name is the Deployment this reconciler protects and watches.
+	Name types.NamespacedName
+	// PVCReconciler is switched into drain mode once our Deployment starts
+	// deleting.
+	PVCReconciler *PVCReconciler
+}
+
+// Reconcile processes events for liberator's own Deployment.
+func (r *DeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues("deployment", req.NamespacedName)
+
+	var deploy appsv1.Deployment
+	if err := r.Get(ctx, req.NamespacedName, &deploy); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	managedPVCs, err := r.countManagedPVCs(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to count managed PVCs")
+		return ctrl.Result{}, err
+	}
+
+	if !deploy.DeletionTimestamp.IsZero() {
+		return r.handleOwnDeletion(ctx, &deploy, managedPVCs, logger)
+	}
+
+	switch {
+	case managedPVCs > 0 && !hasUninstallProtectionFinalizer(deploy.Finalizers):
+		logger.Info("PVCs are under management, protecting own Deployment from deletion", "managedPVCs", managedPVCs)
+		return ctrl.Result{}, r.patchOwnFinalizers(ctx, &deploy, func(f []string) []string {
+			return append(f, UninstallProtectionFinalizer)
+		})
+	case managedPVCs == 0 && hasUninstallProtectionFinalizer(deploy.Finalizers):
+		logger.Info("No PVCs left under management, releasing own Deployment")
+		return ctrl.Result{}, r.patchOwnFinalizers(ctx, &deploy, removeUninstallProtectionFinalizer)
+	default:
+		return ctrl.Result{}, nil
+	}
+}
+
+// handleOwnDeletion drains every managed PVC before letting our own
+// Deployment finalizer go, so `helm uninstall` doesn't strand them.
+func (r *DeploymentReconciler) handleOwnDeletion(ctx context.Context, deploy *appsv1.Deployment, managedPVCs int, logger logr.Logger) (ctrl.Result, error) {
+	if !hasUninstallProtectionFinalizer(deploy.Finalizers) {
+		return ctrl.Result{}, nil
+	}
+
+	if !r.PVCReconciler.DrainMode.Load() {
+		logger.Info("Own Deployment is being deleted, switching PVCReconciler into drain mode", "managedPVCs", managedPVCs)
+		r.PVCReconciler.DrainMode.Store(true)
+	}
+
+	if managedPVCs > 0 {
+		if err := r.drainManagedPVCs(ctx, logger); err != nil {
+			return ctrl.Result{}, err
+		}
+		// Re-check on the next reconcile once the drained PVCs are gone.
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	logger.Info("All managed PVCs drained, releasing own Deployment finalizer")
+	return ctrl.Result{}, r.patchOwnFinalizers(ctx, deploy, removeUninstallProtectionFinalizer)
+}
+
+// drainManagedPVCs force-removes the cleanup finalizer from every PVC that
+// still carries it. PVCs actually being deleted go through the normal
+// deletion path, which also clears their bound PV's claimRef; PVCs that
+// aren't being deleted only have their finalizer removed, since they're
+// still in use and nil-ing their PV's claimRef could let it rebind to
+// something else while still mounted.
+func (r *DeploymentReconciler) drainManagedPVCs(ctx context.Context, logger logr.Logger) error {
+	var pvcs corev1.PersistentVolumeClaimList
+	if err := r.List(ctx, &pvcs); err != nil {
+		return fmt.Errorf("listing PVCs to drain: %w", err)
+	}
+
+	for i := range pvcs.Items {
+		pvc := &pvcs.Items[i]
+		if !hasFinalizer(pvc.Finalizers) {
+			continue
+		}
+
+		pvcLogger := logger.WithValues("pvc", client.ObjectKeyFromObject(pvc))
+		if !pvc.DeletionTimestamp.IsZero() {
+			if _, err := r.PVCReconciler.handlePVCDeletion(ctx, pvc, pvcLogger); err != nil {
+				return fmt.Errorf("draining pvc %s/%s: %w", pvc.Namespace, pvc.Name, err)
+			}
+			continue
+		}
+
+		if _, err := r.PVCReconciler.removeFinalizer(ctx, pvc, pvcLogger); err != nil {
+			return fmt.Errorf("releasing finalizer on pvc %s/%s: %w", pvc.Namespace, pvc.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// countManagedPVCs returns the number of PVCs still carrying our cleanup
+// finalizer.
+func (r *DeploymentReconciler) countManagedPVCs(ctx context.Context) (int, error) {
+	var pvcs corev1.PersistentVolumeClaimList
+	if err := r.List(ctx, &pvcs); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, pvc := range pvcs.Items {
+		if hasFinalizer(pvc.Finalizers) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// patchOwnFinalizers re-fetches the Deployment and applies mutate to its
+// finalizers, retrying on update conflicts.
+func (r *DeploymentReconciler) patchOwnFinalizers(ctx context.Context, deploy *appsv1.Deployment, mutate func([]string) []string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var latest appsv1.Deployment
+		if err := r.Get(ctx, client.ObjectKeyFromObject(deploy), &latest); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		patch := client.MergeFromWithOptions(latest.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		latest.Finalizers = mutate(latest.Finalizers)
+		return r.Patch(ctx, &latest, patch)
+	})
+}
+
+// hasUninstallProtectionFinalizer reports whether finalizers contains
+// UninstallProtectionFinalizer.
+func hasUninstallProtectionFinalizer(finalizers []string) bool {
+	for _, f := range finalizers {
+		if f == UninstallProtectionFinalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// removeUninstallProtectionFinalizer returns finalizers without
+// UninstallProtectionFinalizer.
+func removeUninstallProtectionFinalizer(finalizers []string) []string {
+	result := make([]string, 0, len(finalizers))
+	for _, f := range finalizers {
+		if f != UninstallProtectionFinalizer {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// SetupWithManager registers the reconciler to watch r.Name, plus every PVC
+// so countManagedPVCs gets re-evaluated whenever a PVC gains or loses our
+// cleanup finalizer - not just on the rare Deployment write. Without this,
+// the primary flow (operator installed first, PVCs become managed later)
+// would never add the protection finalizer until the next resync.
+func (r *DeploymentReconciler) SetupWithManager(mgr manager.Manager) error {
+	isDeployment := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := obj.(*appsv1.Deployment)
+		return ok
+	})
+	onlyOwnDeployment := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetNamespace() == r.Name.Namespace && obj.GetName() == r.Name.Name
+	})
+
+	pvcToOwnDeployment := func(ctx context.Context, obj client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: r.Name}}
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&appsv1.Deployment{}).
+		Watches(&corev1.PersistentVolumeClaim{}, handler.EnqueueRequestsFromMapFunc(pvcToOwnDeployment)).
+		WithEventFilter(predicate.Or(predicate.And(isDeployment, onlyOwnDeployment), predicate.Not(isDeployment))).
+		Complete(r)
+}
+
+// discoverOwnDeployment finds the Deployment that owns the currently running
+// Pod, via the POD_NAME/POD_NAMESPACE downward API env vars -> Pod ->
+// ReplicaSet -> Deployment owner chain.
+func discoverOwnDeployment(ctx context.Context, c client.Reader) (types.NamespacedName, error) {
+	podName := os.Getenv("POD_NAME")
+	podNamespace := os.Getenv("POD_NAMESPACE")
+	if podName == "" || podNamespace == "" {
+		return types.NamespacedName{}, fmt.Errorf("POD_NAME/POD_NAMESPACE env vars are not set, cannot discover own Deployment")
+	}
+
+	var pod corev1.Pod
+	if err := c.Get(ctx, types.NamespacedName{Name: podName, Namespace: podNamespace}, &pod); err != nil {
+		return types.NamespacedName{}, fmt.Errorf("getting own pod %s/%s: %w", podNamespace, podName, err)
+	}
+
+	rsName, err := ownerOfKind(pod.OwnerReferences, "ReplicaSet")
+	if err != nil {
+		return types.NamespacedName{}, fmt.Errorf("finding owning ReplicaSet of pod %s/%s: %w", podNamespace, podName, err)
+	}
+
+	var rs appsv1.ReplicaSet
+	if err := c.Get(ctx, types.NamespacedName{Name: rsName, Namespace: podNamespace}, &rs); err != nil {
+		return types.NamespacedName{}, fmt.Errorf("getting ReplicaSet %s/%s: %w", podNamespace, rsName, err)
+	}
+
+	deployName, err := ownerOfKind(rs.OwnerReferences, "Deployment")
+	if err != nil {
+		return types.NamespacedName{}, fmt.Errorf("finding owning Deployment of ReplicaSet %s/%s: %w", podNamespace, rsName, err)
+	}
+
+	return types.NamespacedName{Name: deployName, Namespace: podNamespace}, nil
+}
+
+// ownerOfKind returns the name of the first owner reference of the given
+// kind, or an error if none matches.
+func ownerOfKind(refs []metav1.OwnerReference, kind string) (string, error) {
+	for _, ref := range refs {
+		if ref.Kind == kind {
+			return ref.Name, nil
+		}
+	}
+	return "", fmt.Errorf("no owner reference of kind %q found", kind)
+}